@@ -0,0 +1,90 @@
+// Command sobjectgen connects to Salesforce using package force's username-password flow
+// (force.ForceApiWithPassword), describes the given SObjects, and writes one generated Go source file
+// per object into the target package.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goguardian/go-force/codegen"
+	"github.com/goguardian/go-force/force"
+)
+
+func main() {
+	var (
+		clientID     = flag.String("client-id", "", "Connected app client ID")
+		clientSecret = flag.String("client-secret", "", "Connected app client secret")
+		username     = flag.String("username", "", "Salesforce username")
+		password     = flag.String("password", "", "Salesforce password (+ security token)")
+		environment  = flag.String("environment", "", "Salesforce environment: \"sandbox\" logs in via test.salesforce.com, anything else via login.salesforce.com")
+		apiVersion   = flag.String("api-version", "v52.0", "Salesforce API version")
+		pkg          = flag.String("package", "sobjects", "Go package name for generated files")
+		outDir       = flag.String("out", ".", "Directory to write generated files into")
+		externalID   = flag.String("external-id", "", "External ID field to use, overriding auto-detection")
+		objects      = flag.String("objects", "", "Comma-separated list of SObject API names to generate")
+	)
+	flag.Parse()
+
+	if *objects == "" {
+		log.Fatal("-objects is required")
+	}
+
+	forceAPI, err := force.ForceApiWithPassword(*clientID, *clientSecret, *username, *password, *environment, *apiVersion)
+	if err != nil {
+		log.Fatalf("force.ForceApiWithPassword: %s", err)
+	}
+
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		log.Fatalf("os.MkdirAll: %s", err)
+	}
+
+	if _, err := forceAPI.DescribeSObjects(); err != nil {
+		log.Fatalf("forceAPI.DescribeSObjects: %s", err)
+	}
+
+	for _, name := range strings.Split(*objects, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if err := generate(forceAPI, name, *pkg, *externalID, *outDir); err != nil {
+			log.Fatalf("generate(%s): %s", name, err)
+		}
+	}
+}
+
+// namedSObject lets sobjectgen call DescribeSObject for an API name supplied on the command line,
+// without a concrete generated struct to describe it with.
+type namedSObject string
+
+func (o namedSObject) APIName() string           { return string(o) }
+func (o namedSObject) ExternalIDAPIName() string { return "" }
+
+func generate(forceAPI *force.ForceAPI, apiName, pkg, externalID, outDir string) error {
+	desc, err := forceAPI.DescribeSObject(namedSObject(apiName))
+	if err != nil {
+		return fmt.Errorf("forceAPI.DescribeSObject: %s", err)
+	}
+
+	src, err := codegen.GenerateSObject(desc, codegen.Options{
+		Package:         pkg,
+		ExternalIDField: externalID,
+	})
+	if err != nil {
+		return fmt.Errorf("codegen.GenerateSObject: %s", err)
+	}
+
+	path := filepath.Join(outDir, strings.ToLower(apiName)+".go")
+	if err := os.WriteFile(path, src, 0o644); err != nil {
+		return fmt.Errorf("os.WriteFile: %s", err)
+	}
+
+	fmt.Printf("wrote %s\n", path)
+	return nil
+}
@@ -0,0 +1,148 @@
+// Package codegen generates Go source implementing force.SObject from Salesforce Describe metadata.
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/goguardian/go-force/force"
+)
+
+// Options controls how GenerateSObject renders a Go source file for a single SObject.
+type Options struct {
+	// Package is the name of the package the generated file belongs to.
+	Package string
+	// ExternalIDField overrides the field chosen as the SObject's external ID. When empty, the first
+	// field with ExternalID set on the Describe response is used.
+	ExternalIDField string
+}
+
+type templateField struct {
+	GoName  string
+	GoType  string
+	APIName string
+}
+
+type templateData struct {
+	Package         string
+	StructName      string
+	APIName         string
+	ExternalIDField string
+	Fields          []templateField
+}
+
+var sObjectTemplate = template.Must(template.New("sobject").Parse(`// Code generated by sobjectgen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"time"
+
+	"github.com/goguardian/go-force/force"
+)
+
+// {{.StructName}} is a generated representation of the Salesforce "{{.APIName}}" SObject.
+type {{.StructName}} struct {
+{{- range .Fields}}
+	{{.GoName}} {{.GoType}} ` + "`force:\"{{.APIName}},omitempty\"`" + `
+{{- end}}
+
+	Attributes force.SObjectRecordAttributes ` + "`json:\"attributes\"`" + `
+}
+
+// APIName returns the Salesforce API name of {{.StructName}}.
+func (o {{.StructName}}) APIName() string {
+	return "{{.APIName}}"
+}
+
+// ExternalIDAPIName returns the Salesforce external ID field used to reference {{.StructName}} records.
+func (o {{.StructName}}) ExternalIDAPIName() string {
+	return "{{.ExternalIDField}}"
+}
+`))
+
+// GenerateSObject renders a Go source file implementing force.SObject for the given Describe
+// response, one file per object.
+func GenerateSObject(desc *force.SObjectDescription, opts Options) ([]byte, error) {
+	data := templateData{
+		Package:         opts.Package,
+		StructName:      goName(desc.Name),
+		APIName:         desc.Name,
+		ExternalIDField: opts.ExternalIDField,
+	}
+
+	if data.ExternalIDField == "" {
+		for _, f := range desc.Fields {
+			if f.ExternalID {
+				data.ExternalIDField = f.Name
+				break
+			}
+		}
+	}
+
+	for _, f := range desc.Fields {
+		data.Fields = append(data.Fields, templateField{
+			GoName:  goName(f.Name),
+			GoType:  goType(f),
+			APIName: f.Name,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := sObjectTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("sObjectTemplate.Execute: %s", err)
+	}
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format.Source: %s", err)
+	}
+
+	return out, nil
+}
+
+// goType maps a Salesforce field type to the Go type used to represent it. Nillable date/datetime
+// fields are represented as *time.Time so a missing value is distinguishable from the zero time.
+func goType(f *force.SObjectField) string {
+	switch strings.ToLower(f.Type) {
+	case "boolean":
+		return "bool"
+	case "int":
+		return "int"
+	case "double", "currency", "percent":
+		return "float64"
+	case "date", "datetime":
+		if f.Nillable {
+			return "*time.Time"
+		}
+		return "time.Time"
+	default:
+		return "string"
+	}
+}
+
+// goName converts a Salesforce API field or object name (e.g. "Billing_City__c") into an exported Go
+// identifier (e.g. "BillingCity").
+func goName(apiName string) string {
+	name := strings.TrimSuffix(apiName, "__c")
+
+	var b strings.Builder
+	upperNext := true
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upperNext = true
+		case upperNext:
+			b.WriteRune(unicode.ToUpper(r))
+			upperNext = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
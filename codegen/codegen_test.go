@@ -0,0 +1,41 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/goguardian/go-force/force"
+)
+
+func TestGoName(t *testing.T) {
+	cases := map[string]string{
+		"Billing_City__c": "BillingCity",
+		"Name":            "Name",
+		"Account":         "Account",
+	}
+
+	for in, want := range cases {
+		if got := goName(in); got != want {
+			t.Errorf("goName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestGoType(t *testing.T) {
+	cases := []struct {
+		field force.SObjectField
+		want  string
+	}{
+		{force.SObjectField{Type: "boolean"}, "bool"},
+		{force.SObjectField{Type: "int"}, "int"},
+		{force.SObjectField{Type: "double"}, "float64"},
+		{force.SObjectField{Type: "date", Nillable: true}, "*time.Time"},
+		{force.SObjectField{Type: "date", Nillable: false}, "time.Time"},
+		{force.SObjectField{Type: "string"}, "string"},
+	}
+
+	for _, c := range cases {
+		if got := goType(&c.field); got != c.want {
+			t.Errorf("goType(%+v) = %q, want %q", c.field, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,384 @@
+package force
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// BulkOperation is the DML operation performed by a Bulk API 2.0 ingest job.
+type BulkOperation string
+
+const (
+	BulkOperationInsert     BulkOperation = "insert"
+	BulkOperationUpdate     BulkOperation = "update"
+	BulkOperationUpsert     BulkOperation = "upsert"
+	BulkOperationDelete     BulkOperation = "delete"
+	BulkOperationHardDelete BulkOperation = "hardDelete"
+)
+
+// JobState is the lifecycle state of a Bulk API 2.0 ingest job.
+type JobState string
+
+const (
+	JobStateOpen           JobState = "Open"
+	JobStateUploadComplete JobState = "UploadComplete"
+	JobStateInProgress     JobState = "InProgress"
+	JobStateJobComplete    JobState = "JobComplete"
+	JobStateFailed         JobState = "Failed"
+	JobStateAborted        JobState = "Aborted"
+)
+
+// Job is the response returned when a Bulk API 2.0 ingest job is created.
+type Job struct {
+	ID                  string        `json:"id"`
+	Object              string        `json:"object"`
+	Operation           BulkOperation `json:"operation"`
+	ExternalIDFieldName string        `json:"externalIdFieldName,omitempty"`
+	ContentType         string        `json:"contentType"`
+	State               JobState      `json:"state"`
+}
+
+// JobInfo reports the progress of a Bulk API 2.0 ingest job, as returned by GetJobStatus.
+type JobInfo struct {
+	ID                     string        `json:"id"`
+	Object                 string        `json:"object"`
+	Operation              BulkOperation `json:"operation"`
+	State                  JobState      `json:"state"`
+	NumberRecordsProcessed int           `json:"numberRecordsProcessed"`
+	NumberRecordsFailed    int           `json:"numberRecordsFailed"`
+	ErrorMessage           string        `json:"errorMessage,omitempty"`
+}
+
+// terminal reports whether the job has finished processing, successfully or not.
+func (info *JobInfo) terminal() bool {
+	switch info.State {
+	case JobStateJobComplete, JobStateFailed, JobStateAborted:
+		return true
+	default:
+		return false
+	}
+}
+
+type createIngestJobReq struct {
+	Object              string        `json:"object"`
+	Operation           BulkOperation `json:"operation"`
+	ExternalIDFieldName string        `json:"externalIdFieldName,omitempty"`
+	ContentType         string        `json:"contentType"`
+	LineEnding          string        `json:"lineEnding"`
+}
+
+// CreateIngestJob opens a new Bulk API 2.0 ingest job for object using operation. externalIDField is
+// only required for BulkOperationUpsert.
+// See https://developer.salesforce.com/docs/atlas.en-us.api_asynch.meta/api_asynch/create_job.htm
+func (forceAPI *ForceAPI) CreateIngestJob(object string, operation BulkOperation, externalIDField string) (*Job, error) {
+	uri := fmt.Sprintf("/services/data/%s/jobs/ingest", forceAPI.apiVersion)
+
+	req := createIngestJobReq{
+		Object:              object,
+		Operation:           operation,
+		ExternalIDFieldName: externalIDField,
+		ContentType:         "CSV",
+		LineEnding:          "LF",
+	}
+
+	job := &Job{}
+	if err := forceAPI.Post(uri, nil, req, job); err != nil {
+		return nil, fmt.Errorf("forceAPI.Post: %s", err)
+	}
+
+	return job, nil
+}
+
+// UploadJobData uploads records to an open ingest job as CSV, marshaled from each record's "force"
+// struct tags, since Bulk API 2.0 requires text/csv request bodies rather than JSON.
+func (forceAPI *ForceAPI) UploadJobData(jobID string, records []SObject) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	body, err := sObjectsToCSV(records)
+	if err != nil {
+		return fmt.Errorf("sObjectsToCSV: %s", err)
+	}
+
+	uri := fmt.Sprintf("/services/data/%s/jobs/ingest/%s/batches", forceAPI.apiVersion, jobID)
+
+	if err := forceAPI.putRaw(uri, "text/csv", body); err != nil {
+		return fmt.Errorf("forceAPI.putRaw: %s", err)
+	}
+
+	return nil
+}
+
+type patchJobStateReq struct {
+	State JobState `json:"state"`
+}
+
+// CloseJob marks an ingest job's uploaded data as complete, queuing it for processing.
+func (forceAPI *ForceAPI) CloseJob(jobID string) error {
+	return forceAPI.patchJobState(jobID, JobStateUploadComplete)
+}
+
+// AbortJob stops processing of an ingest job.
+func (forceAPI *ForceAPI) AbortJob(jobID string) error {
+	return forceAPI.patchJobState(jobID, JobStateAborted)
+}
+
+func (forceAPI *ForceAPI) patchJobState(jobID string, state JobState) error {
+	uri := fmt.Sprintf("/services/data/%s/jobs/ingest/%s", forceAPI.apiVersion, jobID)
+
+	req := patchJobStateReq{State: state}
+	if err := forceAPI.Patch(uri, nil, req, nil); err != nil {
+		return fmt.Errorf("forceAPI.Patch: %s", err)
+	}
+
+	return nil
+}
+
+// GetJobStatus retrieves the current state and progress of an ingest job.
+func (forceAPI *ForceAPI) GetJobStatus(jobID string) (*JobInfo, error) {
+	uri := fmt.Sprintf("/services/data/%s/jobs/ingest/%s", forceAPI.apiVersion, jobID)
+
+	info := &JobInfo{}
+	if err := forceAPI.Get(uri, nil, info); err != nil {
+		return nil, fmt.Errorf("forceAPI.Get: %s", err)
+	}
+
+	return info, nil
+}
+
+// GetJobResults downloads the successful, failed, and unprocessed record CSVs for an ingest job.
+// The job should be in a terminal state (see WaitForJob) before calling this.
+func (forceAPI *ForceAPI) GetJobResults(jobID string) (successful, failed, unprocessed io.Reader, err error) {
+	base := fmt.Sprintf("/services/data/%s/jobs/ingest/%s", forceAPI.apiVersion, jobID)
+
+	successful, err = forceAPI.getRaw(base + "/successfulResults/")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("forceAPI.getRaw successfulResults: %s", err)
+	}
+
+	failed, err = forceAPI.getRaw(base + "/failedResults/")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("forceAPI.getRaw failedResults: %s", err)
+	}
+
+	unprocessed, err = forceAPI.getRaw(base + "/unprocessedrecords/")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("forceAPI.getRaw unprocessedrecords: %s", err)
+	}
+
+	return successful, failed, unprocessed, nil
+}
+
+// WaitForJob blocks, polling GetJobStatus every pollInterval, until the ingest job reaches a terminal
+// state (JobComplete, Failed or Aborted) or ctx is canceled.
+func (forceAPI *ForceAPI) WaitForJob(ctx context.Context, jobID string, pollInterval time.Duration) (*JobInfo, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		info, err := forceAPI.GetJobStatus(jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		if info.terminal() {
+			return info, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return info, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// sObjectsToCSV marshals records to CSV, using each field's "force" struct tag as the column name
+// (falling back to the Go field name) and skipping the embedded SObjectRecordAttributes field.
+func sObjectsToCSV(records []SObject) (io.Reader, error) {
+	typ := reflect.TypeOf(records[0])
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	var columns []string
+	var indices []int
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Type == reflect.TypeOf(SObjectRecordAttributes{}) {
+			continue
+		}
+
+		name := forceTagName(field)
+		if name == "-" {
+			continue
+		}
+
+		columns = append(columns, name)
+		indices = append(indices, i)
+	}
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+
+	if err := w.Write(columns); err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		v := reflect.ValueOf(record)
+		for v.Kind() == reflect.Ptr {
+			v = v.Elem()
+		}
+
+		row := make([]string, len(indices))
+		for col, i := range indices {
+			row[col] = csvCellValue(v.Field(i))
+		}
+
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// timeType and timePtrType let csvCellValue special-case time.Time/*time.Time columns, since Bulk API
+// 2.0 requires ISO-8601 timestamps rather than Go's default time.Time string representation.
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	timePtrType = reflect.TypeOf(&time.Time{})
+)
+
+// csvCellValue renders a single record field as a CSV cell. time.Time/*time.Time fields are formatted
+// as RFC3339 (Bulk API 2.0's expected ISO-8601 timestamp format) rather than Go's default %v
+// representation, and a nil *time.Time renders as an empty cell rather than the literal "<nil>".
+func csvCellValue(v reflect.Value) string {
+	switch v.Type() {
+	case timeType:
+		return v.Interface().(time.Time).Format(time.RFC3339)
+	case timePtrType:
+		t := v.Interface().(*time.Time)
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
+
+// forceTagName returns the column name for a struct field, preferring the "force" tag's name
+// component and falling back to the Go field name when no tag is present.
+func forceTagName(field reflect.StructField) string {
+	tag := field.Tag.Get("force")
+	if tag == "" {
+		return field.Name
+	}
+
+	name := strings.Split(tag, ",")[0]
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
+
+// putRaw issues a PUT request with a raw body and explicit content type, used for Bulk API 2.0
+// endpoints that require CSV rather than JSON payloads. Since a large upload can easily outlast an
+// access token, a 401 is retried exactly once after reauthenticating, same as ForceAPI.request. body
+// is read into memory up front so the retry resends the full upload.
+func (forceAPI *ForceAPI) putRaw(uri, contentType string, body io.Reader) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	return forceAPI.putRawAttempt(uri, contentType, data, true)
+}
+
+func (forceAPI *ForceAPI) putRawAttempt(uri, contentType string, data []byte, retryOnExpiry bool) error {
+	req, err := http.NewRequest(http.MethodPut, forceAPI.oauth.InstanceUrl+uri, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+forceAPI.oauth.AccessToken)
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized && retryOnExpiry {
+		if err := forceAPI.reauthenticate(); err != nil {
+			return fmt.Errorf("forceAPI.reauthenticate: %s", err)
+		}
+
+		return forceAPI.putRawAttempt(uri, contentType, data, false)
+	}
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// getRaw issues a GET request and returns the raw response body, used for Bulk API 2.0 result
+// endpoints that return CSV rather than JSON. A 401 is retried exactly once after reauthenticating,
+// same as ForceAPI.request.
+func (forceAPI *ForceAPI) getRaw(uri string) (io.Reader, error) {
+	return forceAPI.getRawAttempt(uri, true)
+}
+
+func (forceAPI *ForceAPI) getRawAttempt(uri string, retryOnExpiry bool) (io.Reader, error) {
+	req, err := http.NewRequest(http.MethodGet, forceAPI.oauth.InstanceUrl+uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+forceAPI.oauth.AccessToken)
+	req.Header.Set("Accept", "text/csv")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized && retryOnExpiry {
+		if err := forceAPI.reauthenticate(); err != nil {
+			return nil, fmt.Errorf("forceAPI.reauthenticate: %s", err)
+		}
+
+		return forceAPI.getRawAttempt(uri, false)
+	}
+
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err := io.Copy(buf, resp.Body); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
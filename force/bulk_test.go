@@ -0,0 +1,55 @@
+package force
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+type bulkTestObject struct {
+	Name      string     `force:"Name,omitempty"`
+	CreatedAt time.Time  `force:"CreatedAt,omitempty"`
+	ClosedAt  *time.Time `force:"ClosedAt,omitempty"`
+
+	Attributes SObjectRecordAttributes `json:"attributes"`
+}
+
+func (bulkTestObject) APIName() string           { return "BulkTestObject__c" }
+func (bulkTestObject) ExternalIDAPIName() string { return "id" }
+
+func TestSObjectsToCSV(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	records := []SObject{
+		bulkTestObject{Name: "one", CreatedAt: created, ClosedAt: &created},
+		bulkTestObject{Name: "two", CreatedAt: created},
+	}
+
+	r, err := sObjectsToCSV(records)
+	if err != nil {
+		t.Fatalf("sObjectsToCSV: %s", err)
+	}
+
+	var buf strings.Builder
+	if _, err := io.Copy(&buf, r); err != nil {
+		t.Fatalf("reading CSV: %s", err)
+	}
+
+	out := buf.String()
+
+	if !strings.HasPrefix(out, "Name,CreatedAt,ClosedAt\n") {
+		t.Fatalf("unexpected header, got: %q", out)
+	}
+
+	if !strings.Contains(out, "one,2026-01-02T03:04:05Z,2026-01-02T03:04:05Z\n") {
+		t.Fatalf("expected RFC3339-formatted timestamps in row, got: %q", out)
+	}
+
+	if !strings.Contains(out, "two,2026-01-02T03:04:05Z,\n") {
+		t.Fatalf("expected empty cell for a nil *time.Time, got: %q", out)
+	}
+
+	if strings.Contains(out, "<nil>") {
+		t.Fatalf("CSV should never contain the literal \"<nil>\", got: %q", out)
+	}
+}
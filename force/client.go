@@ -0,0 +1,157 @@
+package force
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+const (
+	userAgent    = "go-force/1.0.0"
+	contentType  = "application/json"
+	responseType = "application/json"
+)
+
+// Get issues a GET to path with params and unmarshals the (json) result into out.
+func (forceAPI *ForceAPI) Get(path string, params url.Values, out interface{}) error {
+	return forceAPI.request(http.MethodGet, path, params, nil, out)
+}
+
+// Post issues a POST to path with params and payload and unmarshals the (json) result into out.
+func (forceAPI *ForceAPI) Post(path string, params url.Values, payload, out interface{}) error {
+	return forceAPI.request(http.MethodPost, path, params, payload, out)
+}
+
+// Patch issues a PATCH to path with params and payload and unmarshals the (json) result into out.
+func (forceAPI *ForceAPI) Patch(path string, params url.Values, payload, out interface{}) error {
+	return forceAPI.request(http.MethodPatch, path, params, payload, out)
+}
+
+// Delete issues a DELETE to path with params.
+func (forceAPI *ForceAPI) Delete(path string, params url.Values) error {
+	return forceAPI.request(http.MethodDelete, path, params, nil, nil)
+}
+
+// DeleteWithResponse issues a DELETE to path with params and unmarshals the (json) result into out.
+func (forceAPI *ForceAPI) DeleteWithResponse(path string, params url.Values, out interface{}) error {
+	return forceAPI.request(http.MethodDelete, path, params, nil, out)
+}
+
+// request issues an HTTP request against the API. If the response indicates the access token has
+// expired, it re-authenticates (see reauthenticate) and retries the request exactly once.
+func (forceAPI *ForceAPI) request(method, path string, params url.Values, payload, out interface{}) error {
+	return forceAPI.requestAttempt(method, path, params, payload, out, true)
+}
+
+func (forceAPI *ForceAPI) requestAttempt(method, path string, params url.Values, payload, out interface{}, retryOnExpiry bool) error {
+	if err := forceAPI.oauth.Validate(); err != nil {
+		return fmt.Errorf("forceAPI.oauth.Validate: %s", err)
+	}
+
+	var uri bytes.Buffer
+	uri.WriteString(forceAPI.oauth.InstanceUrl)
+	uri.WriteString(path)
+	if len(params) > 0 {
+		uri.WriteString("?")
+		uri.WriteString(params.Encode())
+	}
+
+	var body io.Reader
+	if payload != nil {
+		jsonBytes, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %s", err)
+		}
+
+		body = bytes.NewReader(jsonBytes)
+	}
+
+	req, err := http.NewRequest(method, uri.String(), body)
+	if err != nil {
+		return fmt.Errorf("http.NewRequest: %s", err)
+	}
+
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", responseType)
+	req.Header.Set("Authorization", "Bearer "+forceAPI.oauth.AccessToken)
+
+	forceAPI.traceRequest(req)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("http.DefaultClient.Do: %s", err)
+	}
+	defer resp.Body.Close()
+	forceAPI.traceResponse(resp)
+
+	if resp.StatusCode == http.StatusUnauthorized && retryOnExpiry {
+		if err := forceAPI.reauthenticate(); err != nil {
+			return fmt.Errorf("forceAPI.reauthenticate: %s", err)
+		}
+
+		return forceAPI.requestAttempt(method, path, params, payload, out, false)
+	}
+
+	// Sometimes the force API returns no body; catch this before attempting to unmarshal anything.
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadAll: %s", err)
+	}
+	forceAPI.traceResponseBody(respBytes)
+
+	if out != nil {
+		if err := json.Unmarshal(respBytes, out); err != nil {
+			return fmt.Errorf("unable to unmarshal response to object: %s, body: %s, status: %d", err, respBytes, resp.StatusCode)
+		}
+	}
+
+	// A successful unmarshal into out doesn't rule out the body being a force.com API error array
+	// (e.g. when out is itself a slice), so check for one regardless. Only entries with an errorCode
+	// count; per-record Composite Collections errors (statusCode) are left to the caller.
+	var apiErrors APIErrors
+	if err := json.Unmarshal(respBytes, &apiErrors); err == nil && apiErrors.Validate() {
+		return apiErrors
+	}
+
+	return nil
+}
+
+// reauthenticate re-establishes an access token after a 401, using whichever auth flow this ForceAPI
+// was constructed with.
+func (forceAPI *ForceAPI) reauthenticate() error {
+	if forceAPI.jwtSession != nil {
+		return forceAPI.refreshJWT()
+	}
+
+	if forceAPI.oauth.refreshToken != "" {
+		return forceAPI.RefreshToken()
+	}
+
+	return forceAPI.oauth.Authenticate()
+}
+
+func (forceAPI *ForceAPI) traceRequest(req *http.Request) {
+	if forceAPI.logger != nil {
+		forceAPI.trace("Request:", req, "%v")
+	}
+}
+
+func (forceAPI *ForceAPI) traceResponse(resp *http.Response) {
+	if forceAPI.logger != nil {
+		forceAPI.trace("Response:", resp, "%v")
+	}
+}
+
+func (forceAPI *ForceAPI) traceResponseBody(body []byte) {
+	if forceAPI.logger != nil {
+		forceAPI.trace("Response Body:", string(body), "%s")
+	}
+}
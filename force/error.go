@@ -0,0 +1,34 @@
+package force
+
+import "strings"
+
+// APIErrors is the error shape Salesforce returns for single-record SObject operations (insert,
+// update, upsert-by-external-id) and for request-level failures such as an expired session.
+type APIErrors []*apiError
+
+type apiError struct {
+	Message   string   `json:"message,omitempty"`
+	ErrorCode string   `json:"errorCode,omitempty"`
+	Fields    []string `json:"fields,omitempty"`
+}
+
+func (e APIErrors) Error() string {
+	s := make([]string, len(e))
+	for i, err := range e {
+		s[i] = err.Message + " (" + err.ErrorCode + ")"
+	}
+
+	return strings.Join(s, "\n")
+}
+
+// Validate reports whether e actually carries at least one populated error, since force.com can
+// return an empty/unrelated JSON array that happens to unmarshal into an APIErrors with no content.
+func (e APIErrors) Validate() bool {
+	for _, err := range e {
+		if err.ErrorCode != "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,182 @@
+// Package force provides bindings to the force.com REST API.
+//
+// See http://www.salesforce.com/us/developer/docs/api_rest/
+package force
+
+import "fmt"
+
+const (
+	sObjectsKey        = "sobjects"
+	sObjectKey         = "sobject"
+	sObjectDescribeKey = "describe"
+	rowTemplateKey     = "rowTemplate"
+	idKey              = "{ID}"
+
+	resourcesURI = "/services/data/%v"
+)
+
+// ForceAPILogger is satisfied by *log.Logger; pass one to TraceOn to log requests and responses.
+type ForceAPILogger interface {
+	Printf(format string, v ...interface{})
+}
+
+// ForceAPI is a client for the force.com REST API. Construct one with ForceApiWithPassword,
+// ForceApiWithJWT or ForceApiWithJWTFromFile.
+type ForceAPI struct {
+	apiVersion             string
+	oauth                  *forceOauth
+	apiResources           map[string]string
+	apiSObjects            map[string]*SObjectMetaData
+	apiSObjectDescriptions map[string]*SObjectDescription
+	apiMaxBatchSize        int64
+	jwtSession             *jwtSession
+
+	// SObjectCreateBatchSize, SObjectUpdateBatchSize and SObjectDeleteBatchSize let callers throttle
+	// down the number of records sent per Composite Collections/Tree request (e.g. for wide records
+	// that would otherwise hit request-size limits). Zero (the default) or a value above
+	// maxSObjectBatchSize falls back to defaultSObjectBatchSize.
+	SObjectCreateBatchSize int
+	SObjectUpdateBatchSize int
+	SObjectDeleteBatchSize int
+
+	logger    ForceAPILogger
+	logPrefix string
+}
+
+// ForceApiWithPassword authenticates via the OAuth 2.0 username-password flow and returns a ForceAPI
+// with its SObject and resource caches primed.
+func ForceApiWithPassword(clientID, clientSecret, username, password, environment, apiVersion string) (*ForceAPI, error) {
+	forceAPI := &ForceAPI{
+		apiVersion:             apiVersion,
+		apiResources:           make(map[string]string),
+		apiSObjects:            make(map[string]*SObjectMetaData),
+		apiSObjectDescriptions: make(map[string]*SObjectDescription),
+		oauth: &forceOauth{
+			clientID:     clientID,
+			clientSecret: clientSecret,
+			userName:     username,
+			password:     password,
+			environment:  environment,
+		},
+	}
+
+	if err := forceAPI.oauth.Authenticate(); err != nil {
+		return nil, fmt.Errorf("forceAPI.oauth.Authenticate: %s", err)
+	}
+
+	if err := forceAPI.init(); err != nil {
+		return nil, err
+	}
+
+	return forceAPI, nil
+}
+
+// init primes the API resource and SObject metadata caches. Called once, right after a constructor
+// obtains an access token by whatever means.
+func (forceAPI *ForceAPI) init() error {
+	if err := forceAPI.getResources(); err != nil {
+		return fmt.Errorf("forceAPI.getResources: %s", err)
+	}
+
+	if err := forceAPI.getSObjects(); err != nil {
+		return fmt.Errorf("forceAPI.getSObjects: %s", err)
+	}
+
+	return nil
+}
+
+// TraceOn turns on logging for this ForceAPI. After this is called, all requests and responses are
+// sent to logger, each line prefixed with prefix (if non-empty).
+func (forceAPI *ForceAPI) TraceOn(prefix string, logger ForceAPILogger) {
+	forceAPI.logger = logger
+	forceAPI.logPrefix = prefix
+	if prefix != "" {
+		forceAPI.logPrefix = fmt.Sprintf("%s ", prefix)
+	}
+}
+
+// TraceOff turns off tracing. It is idempotent.
+func (forceAPI *ForceAPI) TraceOff() {
+	forceAPI.logger = nil
+	forceAPI.logPrefix = ""
+}
+
+func (forceAPI *ForceAPI) trace(name string, value interface{}, format string) {
+	if forceAPI.logger != nil {
+		forceAPI.logger.Printf("%s%s "+format+"\n", forceAPI.logPrefix, name, value)
+	}
+}
+
+// SObjectAPIResponse is the response listing all SObjects available to this org.
+type SObjectAPIResponse struct {
+	Encoding     string             `json:"encoding"`
+	MaxBatchSize int64              `json:"maxBatchSize"`
+	SObjects     []*SObjectMetaData `json:"sobjects"`
+}
+
+// SObjectMetaData is the lightweight per-SObject metadata returned when listing all SObjects,
+// including the URLs used to fetch, describe, and address rows of that type.
+type SObjectMetaData struct {
+	Name        string            `json:"name"`
+	Label       string            `json:"label"`
+	LabelPlural string            `json:"labelPlural"`
+	KeyPrefix   string            `json:"keyPrefix"`
+	Custom      bool              `json:"custom"`
+	Createable  bool              `json:"createable"`
+	Updateable  bool              `json:"updateable"`
+	Deletable   bool              `json:"deletable"`
+	Queryable   bool              `json:"queryable"`
+	URLs        map[string]string `json:"urls"`
+}
+
+// SObjectDescription is the full Describe response for a single SObject.
+type SObjectDescription struct {
+	Name       string            `json:"name"`
+	Label      string            `json:"label"`
+	Custom     bool              `json:"custom"`
+	Createable bool              `json:"createable"`
+	Updateable bool              `json:"updateable"`
+	Deletable  bool              `json:"deletable"`
+	Queryable  bool              `json:"queryable"`
+	URLs       map[string]string `json:"urls"`
+	Fields     []*SObjectField   `json:"fields"`
+
+	AllFields string `json:"-"` // Not from force.com API. Used to generate SELECT * queries.
+}
+
+// SObjectField describes a single field on an SObject, as returned by Describe.
+type SObjectField struct {
+	Name       string `json:"name"`
+	Label      string `json:"label"`
+	Type       string `json:"type"`
+	Nillable   bool   `json:"nillable"`
+	Createable bool   `json:"createable"`
+	Updateable bool   `json:"updateable"`
+	ExternalID bool   `json:"externalId"`
+	IDLookup   bool   `json:"idLookup"`
+	Unique     bool   `json:"unique"`
+}
+
+func (forceAPI *ForceAPI) getResources() error {
+	uri := fmt.Sprintf(resourcesURI, forceAPI.apiVersion)
+
+	return forceAPI.Get(uri, nil, &forceAPI.apiResources)
+}
+
+func (forceAPI *ForceAPI) getSObjects() error {
+	uri := forceAPI.apiResources[sObjectsKey]
+
+	list := &SObjectAPIResponse{}
+	if err := forceAPI.Get(uri, nil, list); err != nil {
+		return err
+	}
+
+	forceAPI.apiMaxBatchSize = list.MaxBatchSize
+
+	// The API doesn't return the list of sobjects as a map. Convert it.
+	for _, object := range list.SObjects {
+		forceAPI.apiSObjects[object.Name] = object
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package force
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+)
+
+const (
+	graphMaxNodesPerGraph    = 500
+	graphMaxGraphsPerRequest = 75
+)
+
+// refPlaceholder matches a "@{refId.Field}" style reference to another node in the same Graph.
+var refPlaceholder = regexp.MustCompile(`^@\{([^.}]+)\.`)
+
+// Graph is a single atomic unit of work submitted through the Composite Graph API: a set of related
+// SObjects, linked by reference ID, that are created together in a single transaction. Build one with
+// NewGraph, add nodes with AddNode, then submit one or more Graphs with ExecuteGraphs.
+type Graph struct {
+	id    string
+	nodes []*graphNode
+	seen  map[string]bool
+}
+
+type graphNode struct {
+	referenceID string
+	sObject     SObject
+}
+
+// NewGraph creates an empty Graph identified by id. id is echoed back in ExecuteGraphs' response and
+// only needs to be unique within a single ExecuteGraphs call.
+func (forceAPI *ForceAPI) NewGraph(id string) *Graph {
+	return &Graph{
+		id:   id,
+		seen: map[string]bool{},
+	}
+}
+
+// AddNode registers an SObject to be created as part of the graph, addressable by later nodes in the
+// same graph via "@{referenceID.Field}" placeholders (e.g. a Contact's AccountId set to "@{acct1.id}").
+// Nodes must be added in dependency order: AddNode rejects an SObject that references a reference ID
+// not yet seen, which also rules out reference cycles.
+func (g *Graph) AddNode(referenceID string, sObject SObject) error {
+	if g.seen[referenceID] {
+		return fmt.Errorf("duplicate reference ID: %s", referenceID)
+	}
+
+	if len(g.nodes) >= graphMaxNodesPerGraph {
+		return fmt.Errorf("graph %q exceeds maximum of %d nodes", g.id, graphMaxNodesPerGraph)
+	}
+
+	if err := g.validateReferences(sObject); err != nil {
+		return err
+	}
+
+	g.seen[referenceID] = true
+	g.nodes = append(g.nodes, &graphNode{
+		referenceID: referenceID,
+		sObject:     sObject,
+	})
+
+	return nil
+}
+
+// validateReferences scans sObject's string fields for "@{refId.Field}" placeholders and ensures every
+// referenced ID already belongs to this graph.
+func (g *Graph) validateReferences(sObject SObject) error {
+	v := reflect.ValueOf(sObject)
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.String {
+			continue
+		}
+
+		m := refPlaceholder.FindStringSubmatch(field.String())
+		if m == nil {
+			continue
+		}
+
+		if !g.seen[m[1]] {
+			return fmt.Errorf("node references unknown or not-yet-added reference ID: %s", m[1])
+		}
+	}
+
+	return nil
+}
+
+// GraphNodeResult is the outcome of a single node within a Graph submitted via ExecuteGraphs.
+type GraphNodeResult struct {
+	ReferenceID    string
+	Success        bool
+	ID             string
+	HTTPStatusCode int
+}
+
+type compositeSubrequest struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	ReferenceID string      `json:"referenceId"`
+	Body        interface{} `json:"body"`
+}
+
+type compositeGraphReq struct {
+	GraphID          string                `json:"graphId"`
+	CompositeRequest []compositeSubrequest `json:"compositeRequest"`
+}
+
+type compositeGraphsReq struct {
+	Graphs []compositeGraphReq `json:"graphs"`
+}
+
+type compositeGraphsResp struct {
+	Graphs []struct {
+		GraphID       string `json:"graphId"`
+		IsSuccessful  bool   `json:"isSuccessful"`
+		GraphResponse struct {
+			CompositeResponse []struct {
+				Body           json.RawMessage `json:"body"`
+				HTTPStatusCode int             `json:"httpStatusCode"`
+				ReferenceID    string          `json:"referenceId"`
+			} `json:"compositeResponse"`
+		} `json:"graphResponse"`
+	} `json:"graphs"`
+}
+
+// ExecuteGraphs submits up to graphMaxGraphsPerRequest Graphs in a single request to the Composite
+// Graph API. Each graph's nodes are created as one atomic transaction; graphs are otherwise
+// independent of one another. The returned map is keyed by graph ID.
+// See https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_composite_graph.htm
+func (forceAPI *ForceAPI) ExecuteGraphs(graphs []*Graph) (map[string][]GraphNodeResult, error) {
+	if len(graphs) == 0 {
+		return nil, nil
+	}
+
+	if len(graphs) > graphMaxGraphsPerRequest {
+		return nil, fmt.Errorf("too many graphs in request: %d (max %d)", len(graphs), graphMaxGraphsPerRequest)
+	}
+
+	req := compositeGraphsReq{}
+	for _, g := range graphs {
+		cg := compositeGraphReq{GraphID: g.id}
+		for _, n := range g.nodes {
+			cg.CompositeRequest = append(cg.CompositeRequest, compositeSubrequest{
+				Method:      "POST",
+				URL:         fmt.Sprintf("/services/data/%s/sobjects/%s", forceAPI.apiVersion, n.sObject.APIName()),
+				ReferenceID: n.referenceID,
+				Body:        n.sObject,
+			})
+		}
+		req.Graphs = append(req.Graphs, cg)
+	}
+
+	uri := fmt.Sprintf("/services/data/%s/composite/graph", forceAPI.apiVersion)
+
+	var resp compositeGraphsResp
+	if err := forceAPI.Post(uri, nil, req, &resp); err != nil {
+		return nil, fmt.Errorf("forceAPI.Post: %s", err)
+	}
+
+	results := make(map[string][]GraphNodeResult, len(resp.Graphs))
+	for _, g := range resp.Graphs {
+		var nodeResults []GraphNodeResult
+		for _, sub := range g.GraphResponse.CompositeResponse {
+			var body struct {
+				ID string `json:"id"`
+			}
+			_ = json.Unmarshal(sub.Body, &body)
+
+			nodeResults = append(nodeResults, GraphNodeResult{
+				ReferenceID:    sub.ReferenceID,
+				Success:        sub.HTTPStatusCode >= 200 && sub.HTTPStatusCode < 300,
+				ID:             body.ID,
+				HTTPStatusCode: sub.HTTPStatusCode,
+			})
+		}
+		results[g.GraphID] = nodeResults
+	}
+
+	return results, nil
+}
@@ -0,0 +1,43 @@
+package force
+
+import "testing"
+
+type graphTestObject struct {
+	Name      string `force:"Name,omitempty"`
+	AccountID string `force:"AccountId,omitempty"`
+}
+
+func (graphTestObject) APIName() string           { return "GraphTestObject__c" }
+func (graphTestObject) ExternalIDAPIName() string { return "id" }
+
+func TestGraphAddNodeValidatesReferences(t *testing.T) {
+	forceAPI := &ForceAPI{}
+	g := forceAPI.NewGraph("graph1")
+
+	if err := g.AddNode("acct1", graphTestObject{Name: "Acme"}); err != nil {
+		t.Fatalf("AddNode(acct1): %s", err)
+	}
+
+	if err := g.AddNode("contact1", graphTestObject{Name: "Bob", AccountID: "@{acct1.id}"}); err != nil {
+		t.Fatalf("AddNode(contact1) referencing an earlier node: %s", err)
+	}
+
+	if err := g.AddNode("contact2", graphTestObject{Name: "Alice", AccountID: "@{missing.id}"}); err == nil {
+		t.Fatal("expected AddNode to reject a reference to an unknown ID")
+	}
+
+	if err := g.AddNode("acct1", graphTestObject{Name: "Duplicate"}); err == nil {
+		t.Fatal("expected AddNode to reject a duplicate reference ID")
+	}
+}
+
+func TestGraphAddNodeRejectsCycle(t *testing.T) {
+	forceAPI := &ForceAPI{}
+	g := forceAPI.NewGraph("graph1")
+
+	// A node can only reference IDs added before it, so a self- or forward-reference is rejected as an
+	// unknown reference ID rather than being detected as a cycle after the fact.
+	if err := g.AddNode("a", graphTestObject{Name: "A", AccountID: "@{b.id}"}); err == nil {
+		t.Fatal("expected AddNode to reject a forward reference")
+	}
+}
@@ -0,0 +1,129 @@
+package force
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const jwtAssertionLifetime = 3 * time.Minute
+
+// jwtSession holds the parameters needed to mint a fresh JWT bearer assertion, since the JWT Bearer
+// flow issues no refresh token: every time the access token is rejected, a new assertion is signed
+// and exchanged rather than refreshed.
+type jwtSession struct {
+	clientID   string
+	username   string
+	audience   string
+	privateKey *rsa.PrivateKey
+}
+
+type jwtTokenResp struct {
+	AccessToken string `json:"access_token"`
+	InstanceUrl string `json:"instance_url"`
+	TokenType   string `json:"token_type"`
+	ID          string `json:"id"`
+}
+
+// ForceApiWithJWT constructs a ForceAPI authenticated via the OAuth 2.0 JWT Bearer Token flow, used
+// for server-to-server integrations against a connected app with an uploaded self-signed certificate.
+// audience is the token endpoint host, e.g. "https://login.salesforce.com" or
+// "https://test.salesforce.com".
+// See https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/intro_understanding_jwt_oauth_flow.htm
+func ForceApiWithJWT(clientID, username, audience string, privateKey *rsa.PrivateKey, apiVersion string) (*ForceAPI, error) {
+	forceAPI := &ForceAPI{
+		apiVersion:             apiVersion,
+		apiResources:           make(map[string]string),
+		apiSObjects:            make(map[string]*SObjectMetaData),
+		apiSObjectDescriptions: make(map[string]*SObjectDescription),
+		jwtSession: &jwtSession{
+			clientID:   clientID,
+			username:   username,
+			audience:   audience,
+			privateKey: privateKey,
+		},
+	}
+
+	if err := forceAPI.refreshJWT(); err != nil {
+		return nil, fmt.Errorf("forceAPI.refreshJWT: %s", err)
+	}
+
+	if err := forceAPI.init(); err != nil {
+		return nil, err
+	}
+
+	return forceAPI, nil
+}
+
+// ForceApiWithJWTFromFile is a convenience wrapper around ForceApiWithJWT that loads the connected
+// app's private key from a PEM file on disk.
+func ForceApiWithJWTFromFile(clientID, username, audience, pemPath, apiVersion string) (*ForceAPI, error) {
+	pemBytes, err := os.ReadFile(pemPath)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile: %s", err)
+	}
+
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(pemBytes)
+	if err != nil {
+		return nil, fmt.Errorf("jwt.ParseRSAPrivateKeyFromPEM: %s", err)
+	}
+
+	return ForceApiWithJWT(clientID, username, audience, privateKey, apiVersion)
+}
+
+// refreshJWT signs a fresh JWT assertion and exchanges it for a new access token. Called on
+// construction, and by reauthenticate whenever the API returns a 401, since this flow has no refresh
+// token to fall back on.
+func (forceAPI *ForceAPI) refreshJWT() error {
+	sess := forceAPI.jwtSession
+
+	now := time.Now()
+	claims := jwt.RegisteredClaims{
+		Issuer:    sess.clientID,
+		Subject:   sess.username,
+		Audience:  jwt.ClaimStrings{sess.audience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(jwtAssertionLifetime)),
+	}
+
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(sess.privateKey)
+	if err != nil {
+		return fmt.Errorf("token.SignedString: %s", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+
+	tokenURL := strings.TrimSuffix(sess.audience, "/") + "/services/oauth2/token"
+
+	resp, err := http.PostForm(tokenURL, form)
+	if err != nil {
+		return fmt.Errorf("http.PostForm: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from %s: %d", tokenURL, resp.StatusCode)
+	}
+
+	var tok jwtTokenResp
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return fmt.Errorf("json.NewDecoder.Decode: %s", err)
+	}
+
+	if forceAPI.oauth == nil {
+		forceAPI.oauth = &forceOauth{}
+	}
+	forceAPI.oauth.AccessToken = tok.AccessToken
+	forceAPI.oauth.InstanceUrl = tok.InstanceUrl
+
+	return nil
+}
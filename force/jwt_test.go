@@ -0,0 +1,117 @@
+package force
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// newJWTTestServer returns a fake token endpoint that verifies the incoming assertion was signed by
+// privateKey and for the expected client/username/audience, then returns a fixed access token.
+func newJWTTestServer(t *testing.T, privateKey *rsa.PrivateKey, wantClientID, wantUsername string) *httptest.Server {
+	t.Helper()
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("ParseForm: %s", err)
+		}
+
+		if got := r.FormValue("grant_type"); got != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+			t.Errorf("unexpected grant_type: %s", got)
+		}
+
+		claims := jwt.RegisteredClaims{}
+		_, err := jwt.ParseWithClaims(r.FormValue("assertion"), &claims, func(*jwt.Token) (interface{}, error) {
+			return &privateKey.PublicKey, nil
+		})
+		if err != nil {
+			t.Fatalf("parsing assertion: %s", err)
+		}
+
+		if claims.Issuer != wantClientID {
+			t.Errorf("assertion issuer = %s, want %s", claims.Issuer, wantClientID)
+		}
+
+		if claims.Subject != wantUsername {
+			t.Errorf("assertion subject = %s, want %s", claims.Subject, wantUsername)
+		}
+
+		if len(claims.Audience) != 1 || claims.Audience[0] != srv.URL {
+			t.Errorf("assertion audience = %v, want [%s]", claims.Audience, srv.URL)
+		}
+
+		_ = json.NewEncoder(w).Encode(jwtTokenResp{
+			AccessToken: "jwt-access-token",
+			InstanceUrl: "https://instance.example.com",
+		})
+	}))
+
+	return srv
+}
+
+func TestRefreshJWT(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey: %s", err)
+	}
+
+	srv := newJWTTestServer(t, privateKey, "client1", "user@example.com")
+	defer srv.Close()
+
+	forceAPI := &ForceAPI{
+		jwtSession: &jwtSession{
+			clientID:   "client1",
+			username:   "user@example.com",
+			audience:   srv.URL,
+			privateKey: privateKey,
+		},
+	}
+
+	if err := forceAPI.refreshJWT(); err != nil {
+		t.Fatalf("refreshJWT: %s", err)
+	}
+
+	if forceAPI.oauth.AccessToken != "jwt-access-token" {
+		t.Errorf("AccessToken = %q, want %q", forceAPI.oauth.AccessToken, "jwt-access-token")
+	}
+
+	if forceAPI.oauth.InstanceUrl != "https://instance.example.com" {
+		t.Errorf("InstanceUrl = %q, want %q", forceAPI.oauth.InstanceUrl, "https://instance.example.com")
+	}
+}
+
+func TestReauthenticatePrefersJWT(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey: %s", err)
+	}
+
+	srv := newJWTTestServer(t, privateKey, "client1", "user@example.com")
+	defer srv.Close()
+
+	// A non-empty refreshToken would normally steer reauthenticate toward RefreshToken, but a
+	// jwtSession should take priority, since the JWT Bearer flow never issues a refresh token.
+	forceAPI := &ForceAPI{
+		oauth: &forceOauth{refreshToken: "some-stale-refresh-token"},
+		jwtSession: &jwtSession{
+			clientID:   "client1",
+			username:   "user@example.com",
+			audience:   srv.URL,
+			privateKey: privateKey,
+		},
+	}
+
+	if err := forceAPI.reauthenticate(); err != nil {
+		t.Fatalf("reauthenticate: %s", err)
+	}
+
+	if forceAPI.oauth.AccessToken != "jwt-access-token" {
+		t.Errorf("expected reauthenticate to refresh via JWT, got AccessToken = %q", forceAPI.oauth.AccessToken)
+	}
+}
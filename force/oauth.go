@@ -0,0 +1,121 @@
+package force
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	passwordGrantType = "password"
+	loginURI          = "https://login.salesforce.com/services/oauth2/token"
+	testLoginURI      = "https://test.salesforce.com/services/oauth2/token"
+
+	invalidSessionErrorCode = "INVALID_SESSION_ID"
+)
+
+// forceOauth holds the access token and instance URL obtained from whichever OAuth flow this ForceAPI
+// was constructed with, plus the credentials needed to re-authenticate once that token expires.
+type forceOauth struct {
+	AccessToken string `json:"access_token"`
+	InstanceUrl string `json:"instance_url"`
+	ID          string `json:"id"`
+	IssuedAt    string `json:"issued_at"`
+	Signature   string `json:"signature"`
+
+	clientID      string
+	clientSecret  string
+	refreshToken  string
+	userName      string
+	password      string
+	securityToken string
+	environment   string
+}
+
+// Validate reports whether oauth holds enough information to make a request.
+func (oauth *forceOauth) Validate() error {
+	if oauth == nil || oauth.InstanceUrl == "" || oauth.AccessToken == "" {
+		return fmt.Errorf("invalid force oauth session: %#v", oauth)
+	}
+
+	return nil
+}
+
+// Expired reports whether apiErrors indicates the current access token has expired.
+func (oauth *forceOauth) Expired(apiErrors APIErrors) bool {
+	for _, err := range apiErrors {
+		if err.ErrorCode == invalidSessionErrorCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Authenticate obtains a new access token via the OAuth 2.0 username-password flow.
+func (oauth *forceOauth) Authenticate() error {
+	payload := url.Values{
+		"grant_type":    {passwordGrantType},
+		"client_id":     {oauth.clientID},
+		"client_secret": {oauth.clientSecret},
+		"username":      {oauth.userName},
+		"password":      {oauth.password + oauth.securityToken},
+	}
+
+	uri := loginURI
+	if oauth.environment == "sandbox" {
+		uri = testLoginURI
+	}
+
+	req, err := http.NewRequest(http.MethodPost, uri, strings.NewReader(payload.Encode()))
+	if err != nil {
+		return fmt.Errorf("http.NewRequest: %s", err)
+	}
+
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", responseType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("http.DefaultClient.Do: %s", err)
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("ioutil.ReadAll: %s", err)
+	}
+
+	var apiErrors APIErrors
+	if err := json.Unmarshal(respBytes, &apiErrors); err == nil && apiErrors.Validate() {
+		return apiErrors
+	}
+
+	if err := json.Unmarshal(respBytes, oauth); err != nil {
+		return fmt.Errorf("unable to unmarshal authentication response: %s", err)
+	}
+
+	return nil
+}
+
+// RefreshToken obtains a new access token via the OAuth 2.0 refresh-token flow.
+func (forceAPI *ForceAPI) RefreshToken() error {
+	params := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {forceAPI.oauth.refreshToken},
+		"client_id":     {forceAPI.oauth.clientID},
+		"client_secret": {forceAPI.oauth.clientSecret},
+	}
+
+	res := &forceOauth{}
+	if err := forceAPI.Post("/services/oauth2/token", params, nil, res); err != nil {
+		return err
+	}
+
+	forceAPI.oauth.AccessToken = res.AccessToken
+	return nil
+}
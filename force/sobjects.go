@@ -19,6 +19,7 @@ type SObjectResponse struct {
 	Id      string    `force:"id,omitempty"`
 	Errors  APIErrors `force:"error,omitempty"` //TODO: Not sure if APIErrors is the right object
 	Success bool      `force:"success,omitempty"`
+	Created bool      `force:"created,omitempty"`
 }
 
 func (forceAPI *ForceAPI) DescribeSObjects() (map[string]*SObjectMetaData, error) {
@@ -143,12 +144,60 @@ func (forceAPI *ForceAPI) DeleteSObjectByExternalId(id string, in SObject) (err
 	return
 }
 
+// Default and maximum number of records per batch for Composite Collections / Tree requests. Callers
+// can throttle these down via ForceAPI.SObjectCreateBatchSize, SObjectUpdateBatchSize and
+// SObjectDeleteBatchSize for wide records that hit request-size limits; values outside
+// (0, maxSObjectBatchSize] fall back to the default.
 const (
-	soCreateBatchSize = 200
-	soUpdateBatchSize = 200
-	soDeleteBatchSize = 200
+	defaultSObjectBatchSize = 200
+	maxSObjectBatchSize     = 200
 )
 
+func (forceAPI *ForceAPI) createBatchSize() int {
+	return clampBatchSize(forceAPI.SObjectCreateBatchSize)
+}
+
+func (forceAPI *ForceAPI) updateBatchSize() int {
+	return clampBatchSize(forceAPI.SObjectUpdateBatchSize)
+}
+
+func (forceAPI *ForceAPI) deleteBatchSize() int {
+	return clampBatchSize(forceAPI.SObjectDeleteBatchSize)
+}
+
+func clampBatchSize(configured int) int {
+	if configured <= 0 || configured > maxSObjectBatchSize {
+		return defaultSObjectBatchSize
+	}
+
+	return configured
+}
+
+// doInBatches splits items into consecutive batches of at most limit, sending each batch with send and
+// passing its decoded response to handle, in order. It stops and returns the first error from either,
+// preserving whatever handle has already accumulated through its closure. InsertMultipleSObjects and its
+// siblings, and their *Results counterparts, all follow this same split/send/accumulate shape and share
+// this helper rather than repeating it.
+func doInBatches[T, Resp any](items []T, limit int, send func(batch []T) (Resp, error), handle func(Resp) error) error {
+	for i := 0; i < len(items); i += limit {
+		end := i + limit
+		if end > len(items) {
+			end = len(items)
+		}
+
+		resp, err := send(items[i:end])
+		if err != nil {
+			return err
+		}
+
+		if err := handle(resp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type SObjectRecordAttributes struct {
 	Type        string `json:"type,omitempty"`
 	ReferenceID string `json:"referenceId,omitempty"`
@@ -167,8 +216,8 @@ type sObjectInsertMultipleResp struct {
 	} `json:"results"`
 }
 
-// InsertMultipleSObjects creates multiple unrelated records of the same type in batches of maximum "soCreateBatchSize"
-// size.
+// InsertMultipleSObjects creates multiple unrelated records of the same type in batches of maximum
+// ForceAPI.SObjectCreateBatchSize (default/max 200) size.
 // An incoming `SObject` should have "attributes" property with of type `SObjectRecordAttributes`: `Type` and
 // `ReferenceID` should be filled out with corresponding SObject type (APIName) and some external ID.
 // Note: supported by Salesforce API v45.0 (Spring 2019) and later.
@@ -224,32 +273,26 @@ func (forceAPI *ForceAPI) InsertMultipleSObjects(in []SObject) (err error) {
 
 	uri := fmt.Sprintf("/services/data/%s/composite/tree/%s", forceAPI.apiVersion, soType)
 
-	// Split all records to batches.
-	limit := soCreateBatchSize
-	for i := 0; i < len(in); i += limit {
-		end := i + limit
-		if end > len(in) {
-			end = len(in)
-		}
-
-		records := in[i:end]
-
-		req := sObjectInsertMultipleReq{Records: records}
-		var resp sObjectInsertMultipleResp
-		if err := forceAPI.Post(uri, nil, req, &resp); err != nil {
-			return fmt.Errorf("forceAPI.Post: %s", err)
-		}
-
-		if resp.HasErrors {
-			errRefIDs := []string{}
-			for _, r := range resp.Results {
-				errRefIDs = append(errRefIDs, r.ReferenceID)
+	return doInBatches(in, forceAPI.createBatchSize(),
+		func(batch []SObject) (sObjectInsertMultipleResp, error) {
+			req := sObjectInsertMultipleReq{Records: batch}
+			var resp sObjectInsertMultipleResp
+			if err := forceAPI.Post(uri, nil, req, &resp); err != nil {
+				return resp, fmt.Errorf("forceAPI.Post: %s", err)
 			}
-			return fmt.Errorf("error creating objects, refIDs: %s", strings.Join(errRefIDs, ", "))
-		}
-	}
-
-	return nil
+			return resp, nil
+		},
+		func(resp sObjectInsertMultipleResp) error {
+			if resp.HasErrors {
+				errRefIDs := []string{}
+				for _, r := range resp.Results {
+					errRefIDs = append(errRefIDs, r.ReferenceID)
+				}
+				return fmt.Errorf("error creating objects, refIDs: %s", strings.Join(errRefIDs, ", "))
+			}
+			return nil
+		},
+	)
 }
 
 type sObjectUpdateMultipleReq struct {
@@ -258,16 +301,13 @@ type sObjectUpdateMultipleReq struct {
 }
 
 type sObjectUpdateMultipleResp []struct {
-	ID      string `json:"id"`
-	Success bool   `json:"success"`
-	Errors  []struct {
-		StatusCode string `json:"statusCode"`
-		Message    string `json:"message"`
-	} `json:"errors"`
+	ID      string     `json:"id"`
+	Success bool       `json:"success"`
+	Errors  []APIError `json:"errors"`
 }
 
-// UpdateMultipleSObjects update multiple records of the arbitrary type in batches of maximum "soUpdateBatchSize"
-// size.
+// UpdateMultipleSObjects update multiple records of the arbitrary type in batches of maximum
+// ForceAPI.SObjectUpdateBatchSize (default/max 200) size.
 // An incoming `SObject` should have "id" property with a valid ID value, and "attributes" property with of type
 // `SObjectRecordAttributes`: `Type` should be filled out with corresponding SObject type (APIName).
 // Note: supported by Salesforce API v43.0 (Summer 2018) and later.
@@ -319,56 +359,94 @@ func (forceAPI *ForceAPI) UpdateMultipleSObjects(in []SObject, inTransaction boo
 
 	uri := fmt.Sprintf("/services/data/%s/composite/sobjects", forceAPI.apiVersion)
 
-	// Split all records to batches.
-	limit := soUpdateBatchSize
-	for i := 0; i < len(in); i += limit {
-		end := i + limit
-		if end > len(in) {
-			end = len(in)
-		}
-
-		records := in[i:end]
-
-		req := sObjectUpdateMultipleReq{
-			AllOrNone: inTransaction,
-			Records:   records,
-		}
-		var resp sObjectUpdateMultipleResp
-		if err := forceAPI.Patch(uri, nil, req, &resp); err != nil {
-			return fmt.Errorf("forceAPI.Patch: %s", err)
-		}
+	return doInBatches(in, forceAPI.updateBatchSize(),
+		func(batch []SObject) (sObjectUpdateMultipleResp, error) {
+			req := sObjectUpdateMultipleReq{
+				AllOrNone: inTransaction,
+				Records:   batch,
+			}
+			var resp sObjectUpdateMultipleResp
+			if err := forceAPI.Patch(uri, nil, req, &resp); err != nil {
+				return resp, fmt.Errorf("forceAPI.Patch: %s", err)
+			}
+			return resp, nil
+		},
+		func(resp sObjectUpdateMultipleResp) error {
+			var errs []string
+			for _, res := range resp {
+				if !res.Success {
+					codes := ""
+					for _, e := range res.Errors {
+						codes = fmt.Sprintf("%s, %s", codes, e.StatusCode)
+					}
 
-		// Check response, format errors.
-		var errs []string
-		for _, res := range resp {
-			if !res.Success {
-				codes := ""
-				for _, e := range res.Errors {
-					codes = fmt.Sprintf("%s, %s", codes, e.StatusCode)
+					errs = append(errs, fmt.Sprintf("%s: %s", res.ID, codes))
 				}
+			}
 
-				errs = append(errs, fmt.Sprintf("%s: %s", res.ID, codes))
+			if len(errs) > 0 {
+				return fmt.Errorf("error updating objects: %s", strings.Join(errs, ", "))
 			}
-		}
+			return nil
+		},
+	)
+}
 
-		if len(errs) > 0 {
-			return fmt.Errorf("error updating objects: %s", strings.Join(errs, ", "))
+// UpsertMultipleSObjects upserts multiple records of the same type in batches of maximum
+// ForceAPI.SObjectUpdateBatchSize (default/max 200) size, matching each record against an existing
+// record by externalIDField.
+// As with InsertMultipleSObjects, all records must share a single APIName.
+// Note: supported by Salesforce API v46.0 (Winter 2020) and later.
+// See https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_composite_sobjects_collections_upsert.htm
+func (forceAPI *ForceAPI) UpsertMultipleSObjects(in []SObject, externalIDField string, allOrNone bool) (results []SObjectResponse, err error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	// Check sobject's types are the same.
+	soType := in[0].APIName()
+	for _, o := range in {
+		if o.APIName() != soType {
+			return nil, errors.New("all objects should have the same type (APIName)")
 		}
 	}
 
-	return nil
+	// Check if requested sobject type exists in SF.
+	if _, ok := forceAPI.apiSObjects[soType]; !ok {
+		return nil, fmt.Errorf("SObject type not found: %s", soType)
+	}
+
+	uri := fmt.Sprintf("/services/data/%s/composite/sobjects/%s/%s", forceAPI.apiVersion, soType, externalIDField)
+
+	err = doInBatches(in, forceAPI.updateBatchSize(),
+		func(batch []SObject) ([]SObjectResponse, error) {
+			req := sObjectUpdateMultipleReq{
+				AllOrNone: allOrNone,
+				Records:   batch,
+			}
+			var resp []SObjectResponse
+			if err := forceAPI.Patch(uri, nil, req, &resp); err != nil {
+				return resp, fmt.Errorf("forceAPI.Patch: %s", err)
+			}
+			return resp, nil
+		},
+		func(resp []SObjectResponse) error {
+			results = append(results, resp...)
+			return nil
+		},
+	)
+
+	return results, err
 }
 
 type sObjectDeleteMultipleResp []struct {
-	ID      string `json:"id"`
-	Success bool   `json:"success"`
-	Errors  []struct {
-		StatusCode string `json:"statusCode"`
-		Message    string `json:"message"`
-	} `json:"errors"`
+	ID      string     `json:"id"`
+	Success bool       `json:"success"`
+	Errors  []APIError `json:"errors"`
 }
 
-// DeleteMultipleSObjects deletes multiple sObjects by IDs in batches of maximum "soDeleteBatchSize" size.
+// DeleteMultipleSObjects deletes multiple sObjects by IDs in batches of maximum
+// ForceAPI.SObjectDeleteBatchSize (default/max 200) size.
 // `inTransaction` option controls if deletion of each batch should be performed in a single transaction.
 // Note: supported by Salesforce API v43.0 (Summer 2018) and later.
 // See https://developer.salesforce.com/docs/atlas.en-us.214.0.api_rest.meta/api_rest/resources_composite_sobjects_collections_delete.htm
@@ -384,39 +462,33 @@ func (forceAPI *ForceAPI) DeleteMultipleSObjects(ids []string, inTransaction boo
 		params.Set("allOrNone", "true")
 	}
 
-	// Split all ids to batches.
-	limit := soDeleteBatchSize
-	for i := 0; i < len(ids); i += limit {
-		end := i + limit
-		if end > len(ids) {
-			end = len(ids)
-		}
+	return doInBatches(ids, forceAPI.deleteBatchSize(),
+		func(batch []string) (sObjectDeleteMultipleResp, error) {
+			params.Set("ids", strings.Join(batch, ","))
 
-		batch := ids[i:end]
-		params.Set("ids", strings.Join(batch, ","))
-
-		var resp sObjectDeleteMultipleResp
-		if err := forceAPI.DeleteWithResponse(uri, params, &resp); err != nil {
-			return fmt.Errorf("forceAPI.Delete: %s", err)
-		}
+			var resp sObjectDeleteMultipleResp
+			if err := forceAPI.DeleteWithResponse(uri, params, &resp); err != nil {
+				return resp, fmt.Errorf("forceAPI.Delete: %s", err)
+			}
+			return resp, nil
+		},
+		func(resp sObjectDeleteMultipleResp) error {
+			var errs []string
+			for _, res := range resp {
+				if !res.Success {
+					codes := ""
+					for _, e := range res.Errors {
+						codes = fmt.Sprintf("%s, %s", codes, e.StatusCode)
+					}
 
-		// Check response, format errors.
-		var errs []string
-		for _, res := range resp {
-			if !res.Success {
-				codes := ""
-				for _, e := range res.Errors {
-					codes = fmt.Sprintf("%s, %s", codes, e.StatusCode)
+					errs = append(errs, fmt.Sprintf("%s: %s", res.ID, codes))
 				}
-
-				errs = append(errs, fmt.Sprintf("%s: %s", res.ID, codes))
 			}
-		}
 
-		if len(errs) > 0 {
-			return fmt.Errorf("error deleting objects: %s", strings.Join(errs, ", "))
-		}
-	}
-
-	return
+			if len(errs) > 0 {
+				return fmt.Errorf("error deleting objects: %s", strings.Join(errs, ", "))
+			}
+			return nil
+		},
+	)
 }
@@ -0,0 +1,236 @@
+package force
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// APIError is a single structured error returned by Salesforce for one record in a Composite
+// Collections or Composite Tree response.
+type APIError struct {
+	StatusCode string   `json:"statusCode"`
+	Message    string   `json:"message"`
+	Fields     []string `json:"fields"`
+}
+
+// SObjectResult is the per-record outcome of a Composite Collections or Composite Tree DML call,
+// aligned by index to the input slice across all batches. Unlike InsertMultipleSObjects and its
+// siblings, the returned error from these *Results methods is only non-nil for input validation
+// failures (mixed or unknown SObject types) and transport/auth failures; per-record failures are
+// reported here instead.
+type SObjectResult struct {
+	ReferenceID string
+	ID          string
+	Success     bool
+	Created     bool
+	Errors      []APIError
+}
+
+type sObjectTreeResultResp struct {
+	HasErrors bool `json:"hasErrors"`
+	Results   []struct {
+		ReferenceID string     `json:"referenceId"`
+		ID          string     `json:"id"`
+		Errors      []APIError `json:"errors"`
+	} `json:"results"`
+}
+
+// InsertMultipleSObjectsResults behaves like InsertMultipleSObjects, but returns a per-record
+// SObjectResult instead of aggregating all failures into a single error.
+func (forceAPI *ForceAPI) InsertMultipleSObjectsResults(in []SObject) (results []SObjectResult, err error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	// Check sobject's types are the same.
+	soType := in[0].APIName()
+	for _, o := range in {
+		if o.APIName() != soType {
+			return nil, errors.New("all objects should have the same type (APIName)")
+		}
+	}
+
+	// Check if requested sobject type exists in SF.
+	if _, ok := forceAPI.apiSObjects[soType]; !ok {
+		return nil, fmt.Errorf("SObject type not found: %s", soType)
+	}
+
+	uri := fmt.Sprintf("/services/data/%s/composite/tree/%s", forceAPI.apiVersion, soType)
+
+	err = doInBatches(in, forceAPI.createBatchSize(),
+		func(batch []SObject) (sObjectTreeResultResp, error) {
+			req := sObjectInsertMultipleReq{Records: batch}
+			var resp sObjectTreeResultResp
+			if err := forceAPI.Post(uri, nil, req, &resp); err != nil {
+				return resp, fmt.Errorf("forceAPI.Post: %s", err)
+			}
+			return resp, nil
+		},
+		func(resp sObjectTreeResultResp) error {
+			for _, r := range resp.Results {
+				results = append(results, SObjectResult{
+					ReferenceID: r.ReferenceID,
+					ID:          r.ID,
+					Success:     len(r.Errors) == 0,
+					Created:     len(r.Errors) == 0,
+					Errors:      r.Errors,
+				})
+			}
+			return nil
+		},
+	)
+
+	return results, err
+}
+
+type sObjectCollectionsResultResp []struct {
+	ID      string     `json:"id"`
+	Success bool       `json:"success"`
+	Errors  []APIError `json:"errors"`
+}
+
+// UpdateMultipleSObjectsResults behaves like UpdateMultipleSObjects, but returns a per-record
+// SObjectResult instead of aggregating all failures into a single error.
+func (forceAPI *ForceAPI) UpdateMultipleSObjectsResults(in []SObject, inTransaction bool) (results []SObjectResult, err error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	// Check if requested sobject type exists in SF.
+	for _, o := range in {
+		soType := o.APIName()
+		if _, ok := forceAPI.apiSObjects[soType]; !ok {
+			return nil, fmt.Errorf("SObject type not found: %s", soType)
+		}
+	}
+
+	uri := fmt.Sprintf("/services/data/%s/composite/sobjects", forceAPI.apiVersion)
+
+	err = doInBatches(in, forceAPI.updateBatchSize(),
+		func(batch []SObject) (sObjectCollectionsResultResp, error) {
+			req := sObjectUpdateMultipleReq{
+				AllOrNone: inTransaction,
+				Records:   batch,
+			}
+
+			var resp sObjectCollectionsResultResp
+			if err := forceAPI.Patch(uri, nil, req, &resp); err != nil {
+				return resp, fmt.Errorf("forceAPI.Patch: %s", err)
+			}
+			return resp, nil
+		},
+		func(resp sObjectCollectionsResultResp) error {
+			for _, r := range resp {
+				results = append(results, SObjectResult{
+					ID:      r.ID,
+					Success: r.Success,
+					Errors:  r.Errors,
+				})
+			}
+			return nil
+		},
+	)
+
+	return results, err
+}
+
+// sObjectUpsertCollectionsResultResp is sObjectCollectionsResultResp plus the "created" flag Salesforce
+// only includes on Composite Collections upsert responses, distinguishing an insert from an update.
+type sObjectUpsertCollectionsResultResp []struct {
+	ID      string     `json:"id"`
+	Success bool       `json:"success"`
+	Created bool       `json:"created"`
+	Errors  []APIError `json:"errors"`
+}
+
+// UpsertMultipleSObjectsResults behaves like UpsertMultipleSObjects, but returns a per-record
+// SObjectResult instead of the raw SObjectResponse.
+func (forceAPI *ForceAPI) UpsertMultipleSObjectsResults(in []SObject, externalIDField string, allOrNone bool) (results []SObjectResult, err error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	// Check sobject's types are the same.
+	soType := in[0].APIName()
+	for _, o := range in {
+		if o.APIName() != soType {
+			return nil, errors.New("all objects should have the same type (APIName)")
+		}
+	}
+
+	// Check if requested sobject type exists in SF.
+	if _, ok := forceAPI.apiSObjects[soType]; !ok {
+		return nil, fmt.Errorf("SObject type not found: %s", soType)
+	}
+
+	uri := fmt.Sprintf("/services/data/%s/composite/sobjects/%s/%s", forceAPI.apiVersion, soType, externalIDField)
+
+	err = doInBatches(in, forceAPI.updateBatchSize(),
+		func(batch []SObject) (sObjectUpsertCollectionsResultResp, error) {
+			req := sObjectUpdateMultipleReq{
+				AllOrNone: allOrNone,
+				Records:   batch,
+			}
+
+			var resp sObjectUpsertCollectionsResultResp
+			if err := forceAPI.Patch(uri, nil, req, &resp); err != nil {
+				return resp, fmt.Errorf("forceAPI.Patch: %s", err)
+			}
+			return resp, nil
+		},
+		func(resp sObjectUpsertCollectionsResultResp) error {
+			for _, r := range resp {
+				results = append(results, SObjectResult{
+					ID:      r.ID,
+					Success: r.Success,
+					Created: r.Created,
+					Errors:  r.Errors,
+				})
+			}
+			return nil
+		},
+	)
+
+	return results, err
+}
+
+// DeleteMultipleSObjectsResults behaves like DeleteMultipleSObjects, but returns a per-record
+// SObjectResult instead of aggregating all failures into a single error.
+func (forceAPI *ForceAPI) DeleteMultipleSObjectsResults(ids []string, inTransaction bool) (results []SObjectResult, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	uri := fmt.Sprintf("/services/data/%s/composite/sobjects", forceAPI.apiVersion)
+
+	params := url.Values{}
+	if inTransaction {
+		params.Set("allOrNone", "true")
+	}
+
+	err = doInBatches(ids, forceAPI.deleteBatchSize(),
+		func(batch []string) (sObjectCollectionsResultResp, error) {
+			params.Set("ids", strings.Join(batch, ","))
+
+			var resp sObjectCollectionsResultResp
+			if err := forceAPI.DeleteWithResponse(uri, params, &resp); err != nil {
+				return resp, fmt.Errorf("forceAPI.Delete: %s", err)
+			}
+			return resp, nil
+		},
+		func(resp sObjectCollectionsResultResp) error {
+			for _, r := range resp {
+				results = append(results, SObjectResult{
+					ID:      r.ID,
+					Success: r.Success,
+					Errors:  r.Errors,
+				})
+			}
+			return nil
+		},
+	)
+
+	return results, err
+}
@@ -0,0 +1,201 @@
+package force
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type sobjectsTestObject struct {
+	Name string `force:"Name,omitempty"`
+
+	Attributes SObjectRecordAttributes `json:"attributes"`
+}
+
+func (sobjectsTestObject) APIName() string           { return "SObjectsTestObject__c" }
+func (sobjectsTestObject) ExternalIDAPIName() string { return "externalId__c" }
+
+// newSObjectsTestForceAPI returns a ForceAPI that's already authenticated against srv and has
+// sobjectsTestObject in its SObject cache, as if ForceApiWithPassword's init had already run.
+func newSObjectsTestForceAPI(srv *httptest.Server) *ForceAPI {
+	return &ForceAPI{
+		apiVersion: "v52.0",
+		oauth: &forceOauth{
+			AccessToken: "token",
+			InstanceUrl: srv.URL,
+		},
+		apiSObjects: map[string]*SObjectMetaData{
+			"SObjectsTestObject__c": {Name: "SObjectsTestObject__c"},
+		},
+	}
+}
+
+func TestClampBatchSize(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured int
+		want       int
+	}{
+		{"zero falls back to default", 0, defaultSObjectBatchSize},
+		{"negative falls back to default", -1, defaultSObjectBatchSize},
+		{"over max falls back to default", maxSObjectBatchSize + 1, defaultSObjectBatchSize},
+		{"exactly max is kept", maxSObjectBatchSize, maxSObjectBatchSize},
+		{"a valid value in between is kept", 50, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clampBatchSize(tt.configured); got != tt.want {
+				t.Errorf("clampBatchSize(%d) = %d, want %d", tt.configured, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpsertMultipleSObjects(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"id": "001000000000001", "success": true, "created": true},
+			{"id": "001000000000002", "success": true, "created": false},
+		})
+	}))
+	defer srv.Close()
+
+	forceAPI := newSObjectsTestForceAPI(srv)
+
+	in := []SObject{
+		sobjectsTestObject{Name: "new"},
+		sobjectsTestObject{Name: "existing"},
+	}
+
+	results, err := forceAPI.UpsertMultipleSObjects(in, "externalId__c", true)
+	if err != nil {
+		t.Fatalf("UpsertMultipleSObjects: %s", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+
+	if !results[0].Created {
+		t.Errorf("expected first record (a genuine insert) to have Created = true, got %+v", results[0])
+	}
+
+	if results[1].Created {
+		t.Errorf("expected second record (an update) to have Created = false, got %+v", results[1])
+	}
+}
+
+func TestInsertMultipleSObjectsResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(sObjectTreeResultResp{
+			HasErrors: false,
+			Results: []struct {
+				ReferenceID string     `json:"referenceId"`
+				ID          string     `json:"id"`
+				Errors      []APIError `json:"errors"`
+			}{
+				{ReferenceID: "ref1", ID: "001000000000001"},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	forceAPI := newSObjectsTestForceAPI(srv)
+
+	results, err := forceAPI.InsertMultipleSObjectsResults([]SObject{sobjectsTestObject{Name: "one"}})
+	if err != nil {
+		t.Fatalf("InsertMultipleSObjectsResults: %s", err)
+	}
+
+	if len(results) != 1 || !results[0].Success || !results[0].Created || results[0].ID != "001000000000001" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+}
+
+func TestUpdateMultipleSObjectsResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(sObjectCollectionsResultResp{
+			{ID: "001000000000001", Success: false, Errors: []APIError{{StatusCode: "DUPLICATE_VALUE"}}},
+		})
+	}))
+	defer srv.Close()
+
+	forceAPI := newSObjectsTestForceAPI(srv)
+
+	results, err := forceAPI.UpdateMultipleSObjectsResults([]SObject{sobjectsTestObject{Name: "one"}}, true)
+	if err != nil {
+		t.Fatalf("UpdateMultipleSObjectsResults: %s", err)
+	}
+
+	if len(results) != 1 || results[0].Success || results[0].Created {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+
+	if len(results[0].Errors) != 1 || results[0].Errors[0].StatusCode != "DUPLICATE_VALUE" {
+		t.Fatalf("expected the per-record error to be preserved, got: %+v", results[0].Errors)
+	}
+}
+
+func TestUpsertMultipleSObjectsResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(sObjectUpsertCollectionsResultResp{
+			{ID: "001000000000001", Success: true, Created: true},
+			{ID: "001000000000002", Success: true, Created: false},
+		})
+	}))
+	defer srv.Close()
+
+	forceAPI := newSObjectsTestForceAPI(srv)
+
+	in := []SObject{
+		sobjectsTestObject{Name: "new"},
+		sobjectsTestObject{Name: "existing"},
+	}
+
+	results, err := forceAPI.UpsertMultipleSObjectsResults(in, "externalId__c", true)
+	if err != nil {
+		t.Fatalf("UpsertMultipleSObjectsResults: %s", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+
+	if !results[0].Created {
+		t.Errorf("expected first record (a genuine insert) to have Created = true, got %+v", results[0])
+	}
+
+	if results[1].Created {
+		t.Errorf("expected second record (an update) to have Created = false, got %+v", results[1])
+	}
+}
+
+func TestDeleteMultipleSObjectsResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+
+		_ = json.NewEncoder(w).Encode(sObjectCollectionsResultResp{
+			{ID: "001000000000001", Success: true},
+		})
+	}))
+	defer srv.Close()
+
+	forceAPI := newSObjectsTestForceAPI(srv)
+
+	results, err := forceAPI.DeleteMultipleSObjectsResults([]string{"001000000000001"}, true)
+	if err != nil {
+		t.Fatalf("DeleteMultipleSObjectsResults: %s", err)
+	}
+
+	if len(results) != 1 || !results[0].Success || results[0].ID != "001000000000001" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+}